@@ -0,0 +1,43 @@
+package vector3d
+
+// RotatePoint rotates a single point by the quaternion using the
+// closed-form p + 2s(v x p) + 2(v x (v x p)), rewritten as
+// t = 2(v x p); p + s*t + v x t to avoid the two extra quaternion
+// multiplies RotatePointWithQuaternion pays per call.
+func (q Quat[T]) RotatePoint(p Vec3[T]) Vec3[T] {
+	n := q.Normalize()
+	t := n.V.Cross(p).MultiplyScalar(2)
+	return p.Add(t.MultiplyScalar(n.S)).Add(n.V.Cross(t))
+}
+
+// RotatePoints rotates every point in src into dst using the same
+// quaternion, hoisting the normalization and the 2*v/s terms of
+// RotatePoint out of the per-point work. dst and src must have equal
+// length; dst and src may be the same slice.
+func (q Quat[T]) RotatePoints(dst, src []Vec3[T]) {
+	n := q.Normalize()
+	s := n.S
+	doubledV := n.V.MultiplyScalar(2)
+
+	for i, p := range src {
+		t := doubledV.Cross(p)
+		dst[i] = p.Add(t.MultiplyScalar(s)).Add(n.V.Cross(t))
+	}
+}
+
+// RotatePointsXYZ rotates a flat, interleaved [x0,y0,z0, x1,y1,z1, ...]
+// buffer, such as a GPU-shaped vertex buffer, without copying into
+// Vec3 values. dst and src must have equal length, a multiple of 3;
+// dst and src may be the same slice.
+func (q Quat[T]) RotatePointsXYZ(dst, src []T) {
+	n := q.Normalize()
+	s := n.S
+	doubledV := n.V.MultiplyScalar(2)
+
+	for i := 0; i+2 < len(src); i += 3 {
+		p := Vec3[T]{X: src[i], Y: src[i+1], Z: src[i+2]}
+		t := doubledV.Cross(p)
+		r := p.Add(t.MultiplyScalar(s)).Add(n.V.Cross(t))
+		dst[i], dst[i+1], dst[i+2] = r.X, r.Y, r.Z
+	}
+}
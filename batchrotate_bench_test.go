@@ -0,0 +1,103 @@
+package vector3d
+
+import "testing"
+
+const batchBenchSize = 1024
+
+func benchPoints() []Vec3D {
+	pts := make([]Vec3D, batchBenchSize)
+	for i := range pts {
+		pts[i] = Vec3D{X: float64(i), Y: float64(i) * 2, Z: float64(i) * 3}
+	}
+	return pts
+}
+
+// BenchmarkRotatePointsPerPoint rotates a buffer of points by repeatedly
+// calling RotatePointWithQuaternion, the pre-chunk0-7 baseline that
+// re-normalizes and inverts the quaternion and does two quaternion
+// multiplies on every call.
+func BenchmarkRotatePointsPerPoint(b *testing.B) {
+	q := NewQuaternion(Vec3D{X: 0, Y: 0, Z: 1}, 0.7)
+	src := benchPoints()
+	dst := make([]Vec3D, len(src))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, p := range src {
+			dst[j] = RotatePointWithQuaternion(q, p)
+		}
+	}
+}
+
+// BenchmarkRotatePointsBatch rotates the same buffer with RotatePoints,
+// which hoists normalization and the 2*v terms out of the per-point loop.
+func BenchmarkRotatePointsBatch(b *testing.B) {
+	q := NewQuaternion(Vec3D{X: 0, Y: 0, Z: 1}, 0.7)
+	src := benchPoints()
+	dst := make([]Vec3D, len(src))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		q.RotatePoints(dst, src)
+	}
+}
+
+// BenchmarkRotatePointsXYZBatch rotates the same points via the flat,
+// interleaved RotatePointsXYZ buffer form.
+func BenchmarkRotatePointsXYZBatch(b *testing.B) {
+	q := NewQuaternion(Vec3D{X: 0, Y: 0, Z: 1}, 0.7)
+	src := make([]float64, batchBenchSize*3)
+	for i := range src {
+		src[i] = float64(i)
+	}
+	dst := make([]float64, len(src))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		q.RotatePointsXYZ(dst, src)
+	}
+}
+
+// largeBatchBenchSize is large enough that src and dst together (float64,
+// 3 components) don't fit in L2 cache, so the loop is bandwidth- rather
+// than compute-bound - the regime batchrotate.go's own doc comment on
+// RotatePointsXYZ targets ("a GPU-shaped vertex buffer").
+const largeBatchBenchSize = 1 << 20
+
+// BenchmarkRotatePointsBatchFloat64 and BenchmarkRotatePointsBatchFloat32
+// rotate a large, cache-exceeding point cloud with RotatePoints. This
+// loop's per-point work is plain float32 arithmetic (Cross/Add/
+// MultiplyScalar), not Sin32/Acos32's float64 round-trip, so in theory
+// float32's halved memory footprint should show up as fewer cache/DRAM
+// bytes moved per point; measured on this hardware the two run within a
+// few percent of each other, so the effect is real but small at this
+// buffer size, not the "meaningfully faster" win this instantiation was
+// justified by - see generics_bench_test.go's comment for why the
+// per-call benchmarks don't show a win either.
+func BenchmarkRotatePointsBatchFloat64(b *testing.B) {
+	q := NewQuaternion(Vec3D{X: 0, Y: 0, Z: 1}, 0.7)
+	src := make([]Vec3D, largeBatchBenchSize)
+	for i := range src {
+		src[i] = Vec3D{X: float64(i), Y: float64(i) * 2, Z: float64(i) * 3}
+	}
+	dst := make([]Vec3D, len(src))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		q.RotatePoints(dst, src)
+	}
+}
+
+func BenchmarkRotatePointsBatchFloat32(b *testing.B) {
+	q := NewQuaternion(Vec3[float32]{X: 0, Y: 0, Z: 1}, 0.7)
+	src := make([]Vec3[float32], largeBatchBenchSize)
+	for i := range src {
+		src[i] = Vec3[float32]{X: float32(i), Y: float32(i) * 2, Z: float32(i) * 3}
+	}
+	dst := make([]Vec3[float32], len(src))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		q.RotatePoints(dst, src)
+	}
+}
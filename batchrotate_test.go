@@ -0,0 +1,64 @@
+package vector3d
+
+import "testing"
+
+func TestRotatePointMatchesRotatePointWithQuaternion(t *testing.T) {
+	q := NewQuaternion(Vec3D{X: 1, Y: 2, Z: 3}.Normalize(), 0.9)
+	p := Vec3D{X: 4, Y: -1, Z: 2}
+
+	got := q.RotatePoint(p)
+	want := RotatePointWithQuaternion(q, p)
+	if !approxEqualFloat(got.X, want.X, 1e-9) || !approxEqualFloat(got.Y, want.Y, 1e-9) || !approxEqualFloat(got.Z, want.Z, 1e-9) {
+		t.Errorf("q.RotatePoint(p) = %+v, want %+v", got, want)
+	}
+}
+
+func TestRotatePointsMatchesRotatePointWithQuaternion(t *testing.T) {
+	q := NewQuaternion(Vec3D{X: 0, Y: 1, Z: 0}, 1.1)
+	src := []Vec3D{
+		{X: 1, Y: 0, Z: 0},
+		{X: 0, Y: 2, Z: 0},
+		{X: -1, Y: 1, Z: 3},
+	}
+	dst := make([]Vec3D, len(src))
+	q.RotatePoints(dst, src)
+
+	for i, p := range src {
+		want := RotatePointWithQuaternion(q, p)
+		if !approxEqualFloat(dst[i].X, want.X, 1e-9) || !approxEqualFloat(dst[i].Y, want.Y, 1e-9) || !approxEqualFloat(dst[i].Z, want.Z, 1e-9) {
+			t.Errorf("RotatePoints[%d] = %+v, want %+v", i, dst[i], want)
+		}
+	}
+}
+
+func TestRotatePointsAllowsSameSliceInPlace(t *testing.T) {
+	q := NewQuaternion(Vec3D{X: 0, Y: 0, Z: 1}, 0.5)
+	buf := []Vec3D{{X: 1, Y: 0, Z: 0}, {X: 0, Y: 1, Z: 0}}
+	want := make([]Vec3D, len(buf))
+	for i, p := range buf {
+		want[i] = RotatePointWithQuaternion(q, p)
+	}
+
+	q.RotatePoints(buf, buf)
+
+	for i := range buf {
+		if !approxEqualFloat(buf[i].X, want[i].X, 1e-9) || !approxEqualFloat(buf[i].Y, want[i].Y, 1e-9) || !approxEqualFloat(buf[i].Z, want[i].Z, 1e-9) {
+			t.Errorf("RotatePoints in-place [%d] = %+v, want %+v", i, buf[i], want[i])
+		}
+	}
+}
+
+func TestRotatePointsXYZMatchesRotatePointWithQuaternion(t *testing.T) {
+	q := NewQuaternion(Vec3D{X: 1, Y: 0, Z: 0}, 0.7)
+	src := []float64{1, 0, 0, 0, 2, 0, -1, 1, 3}
+	dst := make([]float64, len(src))
+	q.RotatePointsXYZ(dst, src)
+
+	for i := 0; i+2 < len(src); i += 3 {
+		p := Vec3D{X: src[i], Y: src[i+1], Z: src[i+2]}
+		want := RotatePointWithQuaternion(q, p)
+		if !approxEqualFloat(dst[i], want.X, 1e-9) || !approxEqualFloat(dst[i+1], want.Y, 1e-9) || !approxEqualFloat(dst[i+2], want.Z, 1e-9) {
+			t.Errorf("RotatePointsXYZ[%d:%d] = (%v,%v,%v), want %+v", i, i+2, dst[i], dst[i+1], dst[i+2], want)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package vector3d
+
+import "math"
+
+// orthogonalAxis returns a unit vector orthogonal to v, built by crossing
+// v with whichever world axis has the smallest absolute component in v -
+// this keeps the result numerically well-conditioned.
+func orthogonalAxis(v Vec3D) Vec3D {
+	absX, absY, absZ := math.Abs(v.X), math.Abs(v.Y), math.Abs(v.Z)
+
+	axis := Vec3D{X: 1}
+	if absY <= absX && absY <= absZ {
+		axis = Vec3D{Y: 1}
+	} else if absZ <= absX && absZ <= absY {
+		axis = Vec3D{Z: 1}
+	}
+	return v.Cross(axis).Normalize()
+}
+
+// NewQuaternionFromTo builds the shortest rotation that aligns from with
+// to. When the two directions are anti-parallel there is no unique
+// shortest arc, so an arbitrary axis orthogonal to from is used for a
+// 180 degree rotation instead.
+func NewQuaternionFromTo(from, to Vec3D) Quaternion {
+	f := from.Normalize()
+	t := to.Normalize()
+
+	dot := f.ScalarProduct(t)
+	if dot < -1.0+Epsilon {
+		return NewQuaternion(orthogonalAxis(f), math.Pi)
+	}
+
+	return Quaternion{
+		S: 1.0 + dot,
+		V: f.Cross(t),
+	}.Normalize()
+}
+
+// NewQuaternionLookAt builds a quaternion that orients an object's local
+// +Z axis along forward, with up used to resolve the remaining roll
+// around it. forward and up must not be parallel.
+func NewQuaternionLookAt(forward, up Vec3D) Quaternion {
+	f := forward.Normalize()
+	right := up.Cross(f).Normalize()
+	newUp := f.Cross(right)
+
+	return QuaternionFromMat3(Mat3{M: [3][3]float64{
+		{right.X, newUp.X, f.X},
+		{right.Y, newUp.Y, f.Y},
+		{right.Z, newUp.Z, f.Z},
+	}})
+}
@@ -0,0 +1,46 @@
+package vector3d
+
+import "testing"
+
+func TestNewQuaternionFromToRotatesFromOntoTo(t *testing.T) {
+	from := Vec3D{X: 1, Y: 0, Z: 0}
+	to := Vec3D{X: 0, Y: 1, Z: 0}
+
+	q := NewQuaternionFromTo(from, to)
+	got := RotatePointWithQuaternion(q, from)
+	if !approxEqualFloat(got.X, to.X, 1e-9) || !approxEqualFloat(got.Y, to.Y, 1e-9) || !approxEqualFloat(got.Z, to.Z, 1e-9) {
+		t.Errorf("RotatePointWithQuaternion(NewQuaternionFromTo(from, to), from) = %+v, want %+v", got, to)
+	}
+}
+
+func TestNewQuaternionFromToIdentity(t *testing.T) {
+	from := Vec3D{X: 1, Y: 2, Z: 3}
+	q := NewQuaternionFromTo(from, from)
+	got := RotatePointWithQuaternion(q, from.Normalize())
+	want := from.Normalize()
+	if !approxEqualFloat(got.X, want.X, 1e-9) || !approxEqualFloat(got.Y, want.Y, 1e-9) || !approxEqualFloat(got.Z, want.Z, 1e-9) {
+		t.Errorf("RotatePointWithQuaternion(NewQuaternionFromTo(v, v), v) = %+v, want %+v", got, want)
+	}
+}
+
+func TestNewQuaternionFromToAntiParallel(t *testing.T) {
+	from := Vec3D{X: 1, Y: 0, Z: 0}
+	to := Vec3D{X: -1, Y: 0, Z: 0}
+
+	q := NewQuaternionFromTo(from, to)
+	got := RotatePointWithQuaternion(q, from)
+	if !approxEqualFloat(got.X, to.X, 1e-9) || !approxEqualFloat(got.Y, to.Y, 1e-9) || !approxEqualFloat(got.Z, to.Z, 1e-9) {
+		t.Errorf("RotatePointWithQuaternion(NewQuaternionFromTo(from, -from), from) = %+v, want %+v", got, to)
+	}
+}
+
+func TestNewQuaternionLookAtPointsForwardAlongZ(t *testing.T) {
+	forward := Vec3D{X: 1, Y: 0, Z: 0}
+	up := Vec3D{X: 0, Y: 1, Z: 0}
+
+	q := NewQuaternionLookAt(forward, up)
+	got := RotatePointWithQuaternion(q, Vec3D{Z: 1})
+	if !approxEqualFloat(got.X, forward.X, 1e-9) || !approxEqualFloat(got.Y, forward.Y, 1e-9) || !approxEqualFloat(got.Z, forward.Z, 1e-9) {
+		t.Errorf("local +Z rotated by NewQuaternionLookAt(forward, up) = %+v, want %+v", got, forward)
+	}
+}
@@ -0,0 +1,115 @@
+package vector3d
+
+import "math"
+
+// DualQuaternion represents a rigid-body transform (rotation plus
+// translation) as a dual number whose real and dual parts are both
+// quaternions. Unlike a Mat4, it interpolates (via Sclerp) with constant
+// angular velocity and no shearing, which is why skinning implementations
+// favour it over blending matrices.
+type DualQuaternion struct {
+	Real Quaternion
+	Dual Quaternion
+}
+
+// NewDualQuaternionFromRotationTranslation builds a dual quaternion from
+// a rotation and a translation.
+func NewDualQuaternionFromRotationTranslation(r Quaternion, t Vec3D) DualQuaternion {
+	dual := Quaternion{S: 0, V: t}.Multiply(r).MultiplyScalar(0.5)
+	return DualQuaternion{Real: r, Dual: dual}
+}
+
+// Multiply composes two dual quaternions (non-commutative).
+func (dq DualQuaternion) Multiply(other DualQuaternion) DualQuaternion {
+	return DualQuaternion{
+		Real: dq.Real.Multiply(other.Real),
+		Dual: dq.Real.Multiply(other.Dual).Add(dq.Dual.Multiply(other.Real)),
+	}
+}
+
+// Conjugate returns the quaternion conjugate of both the real and dual
+// parts. For a normalized dual quaternion this is also its inverse.
+func (dq DualQuaternion) Conjugate() DualQuaternion {
+	return DualQuaternion{
+		Real: dq.Real.Conjugate(),
+		Dual: dq.Dual.Conjugate(),
+	}
+}
+
+// Normalize scales the dual quaternion so its real part has unit length,
+// if possible.
+func (dq DualQuaternion) Normalize() DualQuaternion {
+	length := dq.Real.Length()
+	if length < Epsilon {
+		return dq // Avoid division by zero
+	}
+	invLength := 1.0 / length
+	return DualQuaternion{
+		Real: dq.Real.MultiplyScalar(invLength),
+		Dual: dq.Dual.MultiplyScalar(invLength),
+	}
+}
+
+// translation extracts the translation component encoded by a normalized
+// rotation+translation dual quaternion.
+func (dq DualQuaternion) translation() Vec3D {
+	return dq.Dual.Multiply(dq.Real.Conjugate()).MultiplyScalar(2.0).V
+}
+
+// TransformPoint rotates and translates a point by the rigid transform
+// encoded by the dual quaternion.
+func (dq DualQuaternion) TransformPoint(p Vec3D) Vec3D {
+	n := dq.Normalize()
+	return RotatePointWithQuaternion(n.Real, p).Add(n.translation())
+}
+
+// ToMat4 converts the dual quaternion to an equivalent 4x4 transform
+// matrix, for uploading to a shader.
+func (dq DualQuaternion) ToMat4() Mat4 {
+	n := dq.Normalize()
+	m := Mat4FromQuaternion(n.Real)
+	t := n.translation()
+	m.M[0][3] = t.X
+	m.M[1][3] = t.Y
+	m.M[2][3] = t.Z
+	return m
+}
+
+// Sclerp performs screw linear interpolation between two dual
+// quaternions. It decomposes the relative transform a^-1*b into a screw
+// axis, rotation angle and translation along that axis, interpolates
+// each linearly, and recomposes them - giving constant-speed rigid
+// motion with no shearing, unlike interpolating Real and Dual
+// componentwise.
+func Sclerp(a, b DualQuaternion, t float64) DualQuaternion {
+	an := a.Normalize()
+	relative := an.Conjugate().Multiply(b.Normalize())
+
+	axis, angle := relative.Real.AxisAngle()
+	trans := relative.translation()
+
+	var rotation Quaternion
+	var translation Vec3D
+	if angle < Epsilon {
+		rotation = Quaternion{S: 1, V: Vec3D{}}
+		translation = trans.MultiplyScalar(t)
+	} else {
+		along := axis.MultiplyScalar(trans.ScalarProduct(axis))
+		perp := trans.Subtract(along)
+
+		// Closest point on the screw axis to the origin.
+		halfCot := 0.0
+		if s := math.Sin(angle / 2.0); math.Abs(s) > Epsilon {
+			halfCot = math.Cos(angle/2.0) / s
+		}
+		axisPoint := perp.MultiplyScalar(0.5).Add(axis.Cross(perp).MultiplyScalar(0.5 * halfCot))
+
+		rotation = NewQuaternion(axis, angle*t)
+		translation = along.MultiplyScalar(t).
+			Add(axisPoint).
+			Subtract(RotatePointWithQuaternion(rotation, axisPoint))
+	}
+
+	interpolated := NewDualQuaternionFromRotationTranslation(rotation, translation)
+	return an.Multiply(interpolated).Normalize()
+}
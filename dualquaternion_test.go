@@ -0,0 +1,63 @@
+package vector3d
+
+import "testing"
+
+func TestDualQuaternionTransformPointMatchesRotateThenTranslate(t *testing.T) {
+	r := NewQuaternion(Vec3D{X: 0, Y: 0, Z: 1}, 0.8)
+	tr := Vec3D{X: 1, Y: 2, Z: 3}
+	dq := NewDualQuaternionFromRotationTranslation(r, tr)
+
+	p := Vec3D{X: 4, Y: -1, Z: 2}
+	got := dq.TransformPoint(p)
+	want := RotatePointWithQuaternion(r, p).Add(tr)
+
+	if !approxEqualFloat(got.X, want.X, 1e-9) || !approxEqualFloat(got.Y, want.Y, 1e-9) || !approxEqualFloat(got.Z, want.Z, 1e-9) {
+		t.Errorf("dq.TransformPoint(p) = %+v, want %+v", got, want)
+	}
+}
+
+func TestDualQuaternionMultiplyComposesTransforms(t *testing.T) {
+	a := NewDualQuaternionFromRotationTranslation(NewQuaternion(Vec3D{Z: 1}, 0.5), Vec3D{X: 1})
+	b := NewDualQuaternionFromRotationTranslation(NewQuaternion(Vec3D{Y: 1}, 0.3), Vec3D{Y: 2})
+
+	composed := a.Multiply(b)
+	p := Vec3D{X: 1, Y: 1, Z: 1}
+
+	got := composed.TransformPoint(p)
+	want := a.TransformPoint(b.TransformPoint(p))
+
+	if !approxEqualFloat(got.X, want.X, 1e-9) || !approxEqualFloat(got.Y, want.Y, 1e-9) || !approxEqualFloat(got.Z, want.Z, 1e-9) {
+		t.Errorf("a.Multiply(b).TransformPoint(p) = %+v, want %+v", got, want)
+	}
+}
+
+func TestSclerpEndpointsRecoverInputs(t *testing.T) {
+	a := NewDualQuaternionFromRotationTranslation(NewQuaternion(Vec3D{X: 1}, 0.1), Vec3D{X: 1, Y: 0, Z: 0})
+	b := NewDualQuaternionFromRotationTranslation(NewQuaternion(Vec3D{X: 1}, 1.2), Vec3D{X: 0, Y: 2, Z: 0})
+
+	p := Vec3D{X: 1, Y: 1, Z: 1}
+
+	got0 := Sclerp(a, b, 0).TransformPoint(p)
+	want0 := a.TransformPoint(p)
+	if !approxEqualFloat(got0.X, want0.X, 1e-9) || !approxEqualFloat(got0.Y, want0.Y, 1e-9) || !approxEqualFloat(got0.Z, want0.Z, 1e-9) {
+		t.Errorf("Sclerp(a, b, 0).TransformPoint(p) = %+v, want %+v", got0, want0)
+	}
+
+	got1 := Sclerp(a, b, 1).TransformPoint(p)
+	want1 := b.TransformPoint(p)
+	if !approxEqualFloat(got1.X, want1.X, 1e-9) || !approxEqualFloat(got1.Y, want1.Y, 1e-9) || !approxEqualFloat(got1.Z, want1.Z, 1e-9) {
+		t.Errorf("Sclerp(a, b, 1).TransformPoint(p) = %+v, want %+v", got1, want1)
+	}
+}
+
+func TestSclerpNoRotationInterpolatesTranslationLinearly(t *testing.T) {
+	identity := NewQuaternion(Vec3D{X: 1}, 0)
+	a := NewDualQuaternionFromRotationTranslation(identity, Vec3D{X: 0, Y: 0, Z: 0})
+	b := NewDualQuaternionFromRotationTranslation(identity, Vec3D{X: 2, Y: 4, Z: 6})
+
+	mid := Sclerp(a, b, 0.5).TransformPoint(Vec3D{})
+	want := Vec3D{X: 1, Y: 2, Z: 3}
+	if !approxEqualFloat(mid.X, want.X, 1e-9) || !approxEqualFloat(mid.Y, want.Y, 1e-9) || !approxEqualFloat(mid.Z, want.Z, 1e-9) {
+		t.Errorf("Sclerp midpoint with no rotation = %+v, want %+v", mid, want)
+	}
+}
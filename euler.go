@@ -0,0 +1,184 @@
+package vector3d
+
+import "math"
+
+// EulerOrder selects the axis sequence used when converting between
+// quaternions and Euler angles. The first letter is the axis the first
+// angle (Vec3D.X) rotates about, the second letter the second angle
+// (Vec3D.Y), and the third letter the third angle (Vec3D.Z) - the angles
+// are always applied in that order, one after another, about the rotated
+// (intrinsic) axes.
+type EulerOrder int
+
+const (
+	EulerXYZ EulerOrder = iota
+	EulerXZY
+	EulerYXZ
+	EulerYZX
+	EulerZXY
+	EulerZYX
+	EulerXYX
+	EulerXZX
+	EulerYXY
+	EulerYZY
+	EulerZXZ
+	EulerZYZ
+)
+
+// eulerAxes returns the three axis indices (0=X, 1=Y, 2=Z) that the
+// three angles of order are applied about, in order.
+func eulerAxes(order EulerOrder) (int, int, int) {
+	switch order {
+	case EulerXYZ:
+		return 0, 1, 2
+	case EulerXZY:
+		return 0, 2, 1
+	case EulerYXZ:
+		return 1, 0, 2
+	case EulerYZX:
+		return 1, 2, 0
+	case EulerZXY:
+		return 2, 0, 1
+	case EulerZYX:
+		return 2, 1, 0
+	case EulerXYX:
+		return 0, 1, 0
+	case EulerXZX:
+		return 0, 2, 0
+	case EulerYXY:
+		return 1, 0, 1
+	case EulerYZY:
+		return 1, 2, 1
+	case EulerZXZ:
+		return 2, 0, 2
+	default: // EulerZYZ
+		return 2, 1, 2
+	}
+}
+
+// axisUnit returns the unit basis vector for axis (0=X, 1=Y, 2=Z).
+func axisUnit(axis int) Vec3D {
+	switch axis {
+	case 0:
+		return Vec3D{X: 1}
+	case 1:
+		return Vec3D{Y: 1}
+	default:
+		return Vec3D{Z: 1}
+	}
+}
+
+// axisCrossSign returns the sign of e_x cross e_y, i.e. the s such that
+// e_x * e_y = s * e_z for the standard basis vectors, given x != y.
+func axisCrossSign(x, y int) float64 {
+	if (x+1)%3 == y {
+		return 1
+	}
+	return -1
+}
+
+// singleAxisRotationMat3 builds the rotation matrix for a rotation of
+// angle around the given basis axis.
+func singleAxisRotationMat3(axis int, angle float64) Mat3 {
+	return Mat3FromQuaternion(NewQuaternion(axisUnit(axis), angle))
+}
+
+// pureAxisRotationAngle reads off the rotation angle of a matrix assumed
+// to be a pure rotation around the given basis axis.
+func pureAxisRotationAngle(m Mat3, axis int) float64 {
+	switch axis {
+	case 0:
+		return math.Atan2(m.M[2][1], m.M[1][1])
+	case 1:
+		return math.Atan2(m.M[0][2], m.M[2][2])
+	default:
+		return math.Atan2(m.M[1][0], m.M[0][0])
+	}
+}
+
+func clamp(x, lo, hi float64) float64 {
+	if x < lo {
+		return lo
+	}
+	if x > hi {
+		return hi
+	}
+	return x
+}
+
+// NewQuaternionFromEuler builds a quaternion from three angles (in
+// radians) applied in sequence around the axes given by order.
+func NewQuaternionFromEuler(angles Vec3D, order EulerOrder) Quaternion {
+	i, j, k := eulerAxes(order)
+	q := NewQuaternion(axisUnit(i), angles.X)
+	q = q.Multiply(NewQuaternion(axisUnit(j), angles.Y))
+	q = q.Multiply(NewQuaternion(axisUnit(k), angles.Z))
+	return q
+}
+
+// ToEuler decomposes the quaternion into three angles (in radians)
+// applied in sequence around the axes given by order. When the rotation
+// falls into gimbal lock (the middle axis' sine is within Epsilon of
+// +-1) the third angle is fixed at 0 and the first angle absorbs the
+// combined rotation, since the first and third axes have become
+// indistinguishable.
+//
+// This was originally added as a Quaternion method; it is a free function
+// here because Quaternion is now an instantiated alias of the generic
+// Quat[T] (introduced after this API shipped), and Go does not allow
+// defining new methods on an instantiated generic type. Callers using the
+// original q.ToEuler(order) form need to switch to ToEuler(q, order).
+func ToEuler(q Quaternion, order EulerOrder) Vec3D {
+	m := Mat3FromQuaternion(q)
+	i, j, k := eulerAxes(order)
+
+	if i == k {
+		return eulerFromMatrixRepeating(m, i, j)
+	}
+	return eulerFromMatrixDistinct(m, i, j, k)
+}
+
+// eulerFromMatrixDistinct extracts Euler angles for a Tait-Bryan order,
+// where i, j, k are pairwise distinct.
+func eulerFromMatrixDistinct(m Mat3, i, j, k int) Vec3D {
+	signJK := axisCrossSign(j, k) // e_j * e_k = signJK * e_i
+	s := clamp(m.M[i][k]*signJK, -1.0, 1.0)
+	b := math.Asin(s)
+
+	if math.Abs(s) > 1.0-Epsilon {
+		// m is now R_i(a) * R_j(b), whose column j is R_i(a) * e_j.
+		signIJ := axisCrossSign(i, j) // e_i * e_j = signIJ * e_k
+		a := math.Atan2(signIJ*m.M[k][j], m.M[j][j])
+		return Vec3D{X: a, Y: b, Z: 0}
+	}
+
+	signIK := axisCrossSign(i, k) // e_i * e_k = signIK * e_j
+	a := math.Atan2(signIK*m.M[j][k], m.M[k][k])
+
+	residual := singleAxisRotationMat3(i, a).Multiply(singleAxisRotationMat3(j, b)).Transpose().Multiply(m)
+	c := pureAxisRotationAngle(residual, k)
+
+	return Vec3D{X: a, Y: b, Z: c}
+}
+
+// eulerFromMatrixRepeating extracts Euler angles for a proper-Euler
+// order, where the third axis repeats the first (k == i).
+func eulerFromMatrixRepeating(m Mat3, i, j int) Vec3D {
+	l := 3 - i - j // the axis used by neither the first nor the second rotation
+	b := math.Acos(clamp(m.M[i][i], -1.0, 1.0))
+
+	if b < Epsilon || b > math.Pi-Epsilon {
+		// m is now R_i(a) * R_j(b), whose column j is R_i(a) * e_j.
+		signIJ := axisCrossSign(i, j) // e_i * e_j = signIJ * e_l
+		a := math.Atan2(signIJ*m.M[l][j], m.M[j][j])
+		return Vec3D{X: a, Y: b, Z: 0}
+	}
+
+	signIL := axisCrossSign(i, l) // e_i * e_l = signIL * e_j
+	a := math.Atan2(m.M[j][i], signIL*m.M[l][i])
+
+	residual := singleAxisRotationMat3(i, a).Multiply(singleAxisRotationMat3(j, b)).Transpose().Multiply(m)
+	c := pureAxisRotationAngle(residual, i)
+
+	return Vec3D{X: a, Y: b, Z: c}
+}
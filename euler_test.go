@@ -0,0 +1,39 @@
+package vector3d
+
+import (
+	"math"
+	"testing"
+)
+
+var eulerOrders = []EulerOrder{
+	EulerXYZ, EulerXZY, EulerYXZ, EulerYZX, EulerZXY, EulerZYX,
+	EulerXYX, EulerXZX, EulerYXY, EulerYZY, EulerZXZ, EulerZYZ,
+}
+
+func TestEulerRoundTripAwayFromGimbalLock(t *testing.T) {
+	angles := Vec3D{X: 0.3, Y: 0.5, Z: -0.2}
+
+	for _, order := range eulerOrders {
+		q := NewQuaternionFromEuler(angles, order)
+		got := ToEuler(q, order)
+		q2 := NewQuaternionFromEuler(got, order)
+
+		if !approxEqualQuat(q, q2, 1e-9) && !approxEqualQuat(q, Quaternion{S: -q2.S, V: q2.V.MultiplyScalar(-1)}, 1e-9) {
+			t.Errorf("order %v: NewQuaternionFromEuler(ToEuler(q)) = %+v, want %+v (up to sign)", order, q2, q)
+		}
+	}
+}
+
+func TestEulerGimbalLockDoesNotPanic(t *testing.T) {
+	q := NewQuaternion(Vec3D{Y: 1}, math.Pi/2)
+	for _, order := range eulerOrders {
+		got := ToEuler(q, order)
+		back := NewQuaternionFromEuler(got, order)
+
+		m1 := Mat3FromQuaternion(q)
+		m2 := Mat3FromQuaternion(back)
+		if !approxEqualMat3(m1, m2, 1e-9) {
+			t.Errorf("order %v gimbal lock: rotation matrix changed after round trip: got %+v, want %+v", order, m2, m1)
+		}
+	}
+}
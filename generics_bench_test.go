@@ -0,0 +1,72 @@
+package vector3d
+
+import "testing"
+
+// BenchmarkRotatePointWithQuaternionFloat64/Float32,
+// BenchmarkSlerpFloat64/Float32 and BenchmarkVec3NormalizeFloat64/Float32
+// compare the two Quat[T]/Vec3[T] instantiations against each other.
+// float32 is not faster here, and is sometimes measurably slower: Go's
+// math.Sqrt/Sin/Cos/Acos already compile to a single hardware
+// instruction, so math32.go's float64 round-trip doesn't cost what it
+// would on hardware without a native FPU sqrt/trig unit, while the
+// generic Vec3[T]/Quat[T] dispatch (the any() type switch in sqrtT/sinT/
+// cosT/acosT) adds the same overhead to both instantiations. float32's
+// value in this package is memory footprint - matching GPU vertex
+// buffers and halving the bytes moved for large point clouds (see
+// BenchmarkRotatePointsBatchFloat32 in batchrotate_bench_test.go) - not
+// per-call throughput.
+func BenchmarkRotatePointWithQuaternionFloat64(b *testing.B) {
+	q := NewQuaternion(Vec3D{X: 0, Y: 0, Z: 1}, 0.7)
+	p := Vec3D{X: 1, Y: 2, Z: 3}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p = RotatePointWithQuaternion(q, p)
+	}
+}
+
+func BenchmarkRotatePointWithQuaternionFloat32(b *testing.B) {
+	q := NewQuaternion(Vec3[float32]{X: 0, Y: 0, Z: 1}, 0.7)
+	p := Vec3[float32]{X: 1, Y: 2, Z: 3}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p = RotatePointWithQuaternion(q, p)
+	}
+}
+
+func BenchmarkSlerpFloat64(b *testing.B) {
+	a := NewQuaternion(Vec3D{X: 0, Y: 0, Z: 1}, 0.1)
+	c := NewQuaternion(Vec3D{X: 0, Y: 1, Z: 0}, 1.3)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a = Slerp(a, c, 0.3)
+	}
+}
+
+func BenchmarkSlerpFloat32(b *testing.B) {
+	a := NewQuaternion(Vec3[float32]{X: 0, Y: 0, Z: 1}, 0.1)
+	c := NewQuaternion(Vec3[float32]{X: 0, Y: 1, Z: 0}, 1.3)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a = Slerp(a, c, 0.3)
+	}
+}
+
+func BenchmarkVec3NormalizeFloat64(b *testing.B) {
+	v := Vec3D{X: 3, Y: 4, Z: 12}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v = v.Normalize()
+	}
+}
+
+func BenchmarkVec3NormalizeFloat32(b *testing.B) {
+	v := Vec3[float32]{X: 3, Y: 4, Z: 12}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v = v.Normalize()
+	}
+}
@@ -0,0 +1,31 @@
+package vector3d
+
+import "math"
+
+// Sqrt32, Sin32, Cos32 and Acos32 are a minimal float32 shim over the
+// standard library's float64-only math package. The generic Vec3[T]/
+// Quat[T] methods dispatch to these when instantiated with T = float32.
+//
+// This is a round trip through float64, not float32-native computation:
+// Go's math package has no float32 sqrt/trig, and hand-rolled
+// approximations (e.g. the classic fast-inverse-square-root bit trick)
+// measure no faster than this round trip on modern hardware, where
+// math.Sqrt/Sin/Cos/Acos already compile to a single fast instruction -
+// see BenchmarkVec3NormalizeFloat32 (generics_bench_test.go). float32's
+// value here is memory footprint parity with float32-based systems (GPU
+// vertex buffers, mesh data), not CPU throughput.
+func Sqrt32(x float32) float32 {
+	return float32(math.Sqrt(float64(x)))
+}
+
+func Sin32(x float32) float32 {
+	return float32(math.Sin(float64(x)))
+}
+
+func Cos32(x float32) float32 {
+	return float32(math.Cos(float64(x)))
+}
+
+func Acos32(x float32) float32 {
+	return float32(math.Acos(float64(x)))
+}
@@ -0,0 +1,319 @@
+package vector3d
+
+import (
+	"math"
+)
+
+// Mat3 is a 3x3 matrix stored in row-major order, most commonly used to
+// carry a pure rotation (or other linear transform) without translation.
+type Mat3 struct {
+	M [3][3]float64
+}
+
+// Mat4 is a 4x4 matrix stored in row-major order, used for affine
+// transforms combining rotation, scale and translation.
+type Mat4 struct {
+	M [4][4]float64
+}
+
+// NewMat3Identity returns the 3x3 identity matrix.
+func NewMat3Identity() Mat3 {
+	return Mat3{M: [3][3]float64{
+		{1, 0, 0},
+		{0, 1, 0},
+		{0, 0, 1},
+	}}
+}
+
+// NewMat3Scale builds a 3x3 matrix that scales by s along each axis.
+func NewMat3Scale(s Vec3D) Mat3 {
+	return Mat3{M: [3][3]float64{
+		{s.X, 0, 0},
+		{0, s.Y, 0},
+		{0, 0, s.Z},
+	}}
+}
+
+// Multiply performs matrix multiplication (non-commutative).
+func (m Mat3) Multiply(other Mat3) Mat3 {
+	var result Mat3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			sum := 0.0
+			for k := 0; k < 3; k++ {
+				sum += m.M[i][k] * other.M[k][j]
+			}
+			result.M[i][j] = sum
+		}
+	}
+	return result
+}
+
+// Transpose returns the transpose of the matrix.
+func (m Mat3) Transpose() Mat3 {
+	var result Mat3
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			result.M[j][i] = m.M[i][j]
+		}
+	}
+	return result
+}
+
+// Determinant calculates the determinant of the matrix.
+func (m Mat3) Determinant() float64 {
+	return m.M[0][0]*(m.M[1][1]*m.M[2][2]-m.M[1][2]*m.M[2][1]) -
+		m.M[0][1]*(m.M[1][0]*m.M[2][2]-m.M[1][2]*m.M[2][0]) +
+		m.M[0][2]*(m.M[1][0]*m.M[2][1]-m.M[1][1]*m.M[2][0])
+}
+
+// Invert calculates the inverse of the matrix, if possible.
+func (m Mat3) Invert() Mat3 {
+	det := m.Determinant()
+	if math.Abs(det) < Epsilon {
+		return m // Avoid division by zero
+	}
+	invDet := 1.0 / det
+
+	var r Mat3
+	r.M[0][0] = (m.M[1][1]*m.M[2][2] - m.M[1][2]*m.M[2][1]) * invDet
+	r.M[0][1] = (m.M[0][2]*m.M[2][1] - m.M[0][1]*m.M[2][2]) * invDet
+	r.M[0][2] = (m.M[0][1]*m.M[1][2] - m.M[0][2]*m.M[1][1]) * invDet
+	r.M[1][0] = (m.M[1][2]*m.M[2][0] - m.M[1][0]*m.M[2][2]) * invDet
+	r.M[1][1] = (m.M[0][0]*m.M[2][2] - m.M[0][2]*m.M[2][0]) * invDet
+	r.M[1][2] = (m.M[0][2]*m.M[1][0] - m.M[0][0]*m.M[1][2]) * invDet
+	r.M[2][0] = (m.M[1][0]*m.M[2][1] - m.M[1][1]*m.M[2][0]) * invDet
+	r.M[2][1] = (m.M[0][1]*m.M[2][0] - m.M[0][0]*m.M[2][1]) * invDet
+	r.M[2][2] = (m.M[0][0]*m.M[1][1] - m.M[0][1]*m.M[1][0]) * invDet
+	return r
+}
+
+// Mat3FromQuaternion converts a unit quaternion to its equivalent rotation matrix.
+func Mat3FromQuaternion(q Quaternion) Mat3 {
+	x, y, z, s := q.V.X, q.V.Y, q.V.Z, q.S
+
+	return Mat3{M: [3][3]float64{
+		{1 - 2*(y*y+z*z), 2 * (x*y - s*z), 2 * (x*z + s*y)},
+		{2 * (x*y + s*z), 1 - 2*(x*x+z*z), 2 * (y*z - s*x)},
+		{2 * (x*z - s*y), 2 * (y*z + s*x), 1 - 2*(x*x+y*y)},
+	}}
+}
+
+// QuaternionFromMat3 converts a rotation matrix back to a unit quaternion
+// using Shepperd's method: the largest of the trace and the three diagonal
+// alternatives is chosen to solve for first, which avoids the singularity
+// the naive trace-based formula hits when the trace is negative.
+func QuaternionFromMat3(m Mat3) Quaternion {
+	m00, m01, m02 := m.M[0][0], m.M[0][1], m.M[0][2]
+	m10, m11, m12 := m.M[1][0], m.M[1][1], m.M[1][2]
+	m20, m21, m22 := m.M[2][0], m.M[2][1], m.M[2][2]
+
+	trace := m00 + m11 + m22
+
+	switch {
+	case m11+m22 >= 0 && m00+m22 >= 0 && m00+m11 >= 0: // 1+trace is the largest
+		s := math.Sqrt(trace+1.0) * 2.0 // s = 4*qw
+		return Quaternion{
+			S: 0.25 * s,
+			V: Vec3D{
+				X: (m21 - m12) / s,
+				Y: (m02 - m20) / s,
+				Z: (m10 - m01) / s,
+			},
+		}
+	case m00 > m11 && m00 > m22:
+		s := math.Sqrt(1.0+m00-m11-m22) * 2.0 // s = 4*qx
+		return Quaternion{
+			S: (m21 - m12) / s,
+			V: Vec3D{
+				X: 0.25 * s,
+				Y: (m01 + m10) / s,
+				Z: (m02 + m20) / s,
+			},
+		}
+	case m11 > m22:
+		s := math.Sqrt(1.0-m00+m11-m22) * 2.0 // s = 4*qy
+		return Quaternion{
+			S: (m02 - m20) / s,
+			V: Vec3D{
+				X: (m01 + m10) / s,
+				Y: 0.25 * s,
+				Z: (m12 + m21) / s,
+			},
+		}
+	default:
+		s := math.Sqrt(1.0-m00-m11+m22) * 2.0 // s = 4*qz
+		return Quaternion{
+			S: (m10 - m01) / s,
+			V: Vec3D{
+				X: (m02 + m20) / s,
+				Y: (m12 + m21) / s,
+				Z: 0.25 * s,
+			},
+		}
+	}
+}
+
+// ToMat3 converts the quaternion to its equivalent 3x3 rotation matrix.
+// This was originally added as a Quaternion method; it is a free function
+// here because Quaternion is now an instantiated alias of the generic
+// Quat[T] (introduced after this API shipped), and Go does not allow
+// defining new methods on an instantiated generic type. Callers using the
+// original q.ToMat3() form need to switch to ToMat3(q).
+func ToMat3(q Quaternion) Mat3 {
+	return Mat3FromQuaternion(q)
+}
+
+// ToMat4 converts the quaternion to its equivalent 4x4 rotation matrix.
+// See ToMat3 for why this is a free function rather than a method.
+func ToMat4(q Quaternion) Mat4 {
+	return Mat4FromQuaternion(q)
+}
+
+// NewMat4Identity returns the 4x4 identity matrix.
+func NewMat4Identity() Mat4 {
+	var m Mat4
+	for i := 0; i < 4; i++ {
+		m.M[i][i] = 1
+	}
+	return m
+}
+
+// NewMat4FromMat3 embeds a 3x3 matrix into the upper-left block of a 4x4
+// matrix, with no translation and a homogeneous 1 in the bottom right.
+func NewMat4FromMat3(m Mat3) Mat4 {
+	var result Mat4
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			result.M[i][j] = m.M[i][j]
+		}
+	}
+	result.M[3][3] = 1
+	return result
+}
+
+// NewMat4Translation builds a 4x4 matrix that translates by t.
+func NewMat4Translation(t Vec3D) Mat4 {
+	m := NewMat4Identity()
+	m.M[0][3] = t.X
+	m.M[1][3] = t.Y
+	m.M[2][3] = t.Z
+	return m
+}
+
+// NewMat4Scale builds a 4x4 matrix that scales by s along each axis.
+func NewMat4Scale(s Vec3D) Mat4 {
+	return NewMat4FromMat3(NewMat3Scale(s))
+}
+
+// Mat4FromQuaternion converts a unit quaternion to a 4x4 rotation matrix
+// with no translation.
+func Mat4FromQuaternion(q Quaternion) Mat4 {
+	return NewMat4FromMat3(Mat3FromQuaternion(q))
+}
+
+// Multiply performs matrix multiplication (non-commutative).
+func (m Mat4) Multiply(other Mat4) Mat4 {
+	var result Mat4
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			sum := 0.0
+			for k := 0; k < 4; k++ {
+				sum += m.M[i][k] * other.M[k][j]
+			}
+			result.M[i][j] = sum
+		}
+	}
+	return result
+}
+
+// Transpose returns the transpose of the matrix.
+func (m Mat4) Transpose() Mat4 {
+	var result Mat4
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			result.M[j][i] = m.M[i][j]
+		}
+	}
+	return result
+}
+
+// minor returns the 3x3 matrix obtained by deleting the given row and column.
+func (m Mat4) minor(row, col int) Mat3 {
+	var r Mat3
+	ri := 0
+	for i := 0; i < 4; i++ {
+		if i == row {
+			continue
+		}
+		rj := 0
+		for j := 0; j < 4; j++ {
+			if j == col {
+				continue
+			}
+			r.M[ri][rj] = m.M[i][j]
+			rj++
+		}
+		ri++
+	}
+	return r
+}
+
+// cofactor returns the signed minor determinant at the given row and column.
+func (m Mat4) cofactor(row, col int) float64 {
+	det := m.minor(row, col).Determinant()
+	if (row+col)%2 != 0 {
+		return -det
+	}
+	return det
+}
+
+// Determinant calculates the determinant of the matrix by cofactor expansion
+// along the first row.
+func (m Mat4) Determinant() float64 {
+	det := 0.0
+	for j := 0; j < 4; j++ {
+		det += m.M[0][j] * m.cofactor(0, j)
+	}
+	return det
+}
+
+// Invert calculates the inverse of the matrix, if possible, via the adjugate.
+func (m Mat4) Invert() Mat4 {
+	det := m.Determinant()
+	if math.Abs(det) < Epsilon {
+		return m // Avoid division by zero
+	}
+	invDet := 1.0 / det
+
+	var result Mat4
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			// Adjugate is the transpose of the cofactor matrix.
+			result.M[j][i] = m.cofactor(i, j) * invDet
+		}
+	}
+	return result
+}
+
+// TransformPoint applies the matrix to a point, including translation.
+func (m Mat4) TransformPoint(p Vec3D) Vec3D {
+	x := m.M[0][0]*p.X + m.M[0][1]*p.Y + m.M[0][2]*p.Z + m.M[0][3]
+	y := m.M[1][0]*p.X + m.M[1][1]*p.Y + m.M[1][2]*p.Z + m.M[1][3]
+	z := m.M[2][0]*p.X + m.M[2][1]*p.Y + m.M[2][2]*p.Z + m.M[2][3]
+	w := m.M[3][0]*p.X + m.M[3][1]*p.Y + m.M[3][2]*p.Z + m.M[3][3]
+
+	if math.Abs(w-1.0) < Epsilon || math.Abs(w) < Epsilon {
+		return Vec3D{X: x, Y: y, Z: z}
+	}
+	return Vec3D{X: x / w, Y: y / w, Z: z / w}
+}
+
+// TransformDirection applies the matrix to a direction, ignoring translation.
+func (m Mat4) TransformDirection(d Vec3D) Vec3D {
+	return Vec3D{
+		X: m.M[0][0]*d.X + m.M[0][1]*d.Y + m.M[0][2]*d.Z,
+		Y: m.M[1][0]*d.X + m.M[1][1]*d.Y + m.M[1][2]*d.Z,
+		Z: m.M[2][0]*d.X + m.M[2][1]*d.Y + m.M[2][2]*d.Z,
+	}
+}
@@ -0,0 +1,67 @@
+package vector3d
+
+import (
+	"math"
+	"testing"
+)
+
+func approxEqualMat3(a, b Mat3, tol float64) bool {
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if !approxEqualFloat(a.M[i][j], b.M[i][j], tol) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestMat3FromQuaternionRoundTrip(t *testing.T) {
+	q := NewQuaternion(Vec3D{X: 1, Y: 2, Z: 3}.Normalize(), 0.9)
+	got := QuaternionFromMat3(Mat3FromQuaternion(q))
+	if !approxEqualQuat(got, q, 1e-9) && !approxEqualQuat(got, Quaternion{S: -q.S, V: q.V.MultiplyScalar(-1)}, 1e-9) {
+		t.Errorf("QuaternionFromMat3(Mat3FromQuaternion(q)) = %+v, want %+v (up to sign)", got, q)
+	}
+}
+
+func TestMat3InvertRecoversIdentity(t *testing.T) {
+	m := Mat3FromQuaternion(NewQuaternion(Vec3D{X: 0, Y: 1, Z: 0}, 0.6))
+	got := m.Multiply(m.Invert())
+	if !approxEqualMat3(got, NewMat3Identity(), 1e-9) {
+		t.Errorf("m * m.Invert() = %+v, want identity", got)
+	}
+}
+
+func TestMat3InvertSingularIsNoOp(t *testing.T) {
+	singular := Mat3{} // all-zero, determinant 0
+	got := singular.Invert()
+	if !approxEqualMat3(got, singular, 0) {
+		t.Errorf("Invert() of a singular matrix = %+v, want unchanged %+v", got, singular)
+	}
+}
+
+func TestMat4TransformPointMatchesRotatePoint(t *testing.T) {
+	q := NewQuaternion(Vec3D{X: 0, Y: 0, Z: 1}, 1.0)
+	m := ToMat4(q)
+	p := Vec3D{X: 1, Y: 2, Z: 3}
+
+	got := m.TransformPoint(p)
+	want := RotatePointWithQuaternion(q, p)
+	if math.Abs(got.X-want.X) > 1e-9 || math.Abs(got.Y-want.Y) > 1e-9 || math.Abs(got.Z-want.Z) > 1e-9 {
+		t.Errorf("Mat4FromQuaternion(q).TransformPoint(p) = %+v, want %+v", got, want)
+	}
+}
+
+func TestMat4InvertRecoversIdentity(t *testing.T) {
+	m := NewMat4Translation(Vec3D{X: 1, Y: -2, Z: 3}).Multiply(ToMat4(NewQuaternion(Vec3D{X: 1, Y: 0, Z: 0}, 0.4)))
+	got := m.Multiply(m.Invert())
+	want := NewMat4Identity()
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if !approxEqualFloat(got.M[i][j], want.M[i][j], 1e-9) {
+				t.Errorf("m * m.Invert() = %+v, want identity", got)
+				return
+			}
+		}
+	}
+}
@@ -5,43 +5,109 @@ import (
 	"math"
 )
 
+// Float is the set of floating point types Vec3 and Quat can be
+// parameterized over - float64 for general/scientific use, float32 to
+// match GPU buffers and halve memory in tight rotation loops.
+type Float interface {
+	~float32 | ~float64
+}
+
+// epsilonFor returns the tolerance used for "close to zero"/"close to
+// one" comparisons for T. float32 carries far fewer significant digits
+// than float64, so it needs a correspondingly looser tolerance.
+func epsilonFor[T Float]() T {
+	var zero T
+	switch any(zero).(type) {
+	case float32:
+		return T(1e-5)
+	default:
+		return T(1e-9)
+	}
+}
+
+// Epsilon is the tolerance used by Mat3/Mat4/DualQuaternion and the
+// Euler/FromTo/LookAt constructors, all of which only ever operate on
+// float64. It is intentionally independent of epsilonFor - those call
+// sites predate the generics refactor and were tuned against 1e-5, so
+// epsilonFor[T]() (used by the generic Vec3[T]/Quat[T] methods) tightening
+// to 1e-9 for float64 must not silently change their behavior.
 const Epsilon = 1e-5
 
-type Vec3D struct {
-	X float64 `json:"x"`
-	Y float64 `json:"y"`
-	Z float64 `json:"z"`
+// sqrtT, sinT, cosT and acosT dispatch to the float32 math32 shim or to
+// the standard float64 math package, depending on T.
+func sqrtT[T Float](x T) T {
+	if v, ok := any(x).(float32); ok {
+		return T(Sqrt32(v))
+	}
+	return T(math.Sqrt(float64(x)))
 }
 
-type Quaternion struct {
-	S float64
-	V Vec3D
+func sinT[T Float](x T) T {
+	if v, ok := any(x).(float32); ok {
+		return T(Sin32(v))
+	}
+	return T(math.Sin(float64(x)))
 }
 
-// Vec3D Functions
+func cosT[T Float](x T) T {
+	if v, ok := any(x).(float32); ok {
+		return T(Cos32(v))
+	}
+	return T(math.Cos(float64(x)))
+}
+
+func acosT[T Float](x T) T {
+	if v, ok := any(x).(float32); ok {
+		return T(Acos32(v))
+	}
+	return T(math.Acos(float64(x)))
+}
+
+// Vec3 is a 3D vector parameterized over its component type.
+type Vec3[T Float] struct {
+	X T `json:"x"`
+	Y T `json:"y"`
+	Z T `json:"z"`
+}
+
+// Quat is a quaternion parameterized over its component type.
+type Quat[T Float] struct {
+	S T
+	V Vec3[T]
+}
+
+// Vec3D and Quaternion are the float64 instantiations used throughout
+// existing code; they keep all prior callers of this package compiling
+// unchanged.
+type (
+	Vec3D      = Vec3[float64]
+	Quaternion = Quat[float64]
+)
+
+// Vec3 Functions
 
 // NewVec3D creates a new 3D vector with the given x, y, z components.
-func NewVec3D(x, y, z float64) Vec3D {
-	return Vec3D{X: x, Y: y, Z: z}
+func NewVec3D[T Float](x, y, z T) Vec3[T] {
+	return Vec3[T]{X: x, Y: y, Z: z}
 }
 
 // Length calculates the magnitude of the vector.
-func (v Vec3D) Length() float64 {
-	return math.Sqrt(v.X*v.X + v.Y*v.Y + v.Z*v.Z)
+func (v Vec3[T]) Length() T {
+	return sqrtT(v.X*v.X + v.Y*v.Y + v.Z*v.Z)
 }
 
 // Normalize scales the vector to have a length of 1, if possible.
-func (v Vec3D) Normalize() Vec3D {
+func (v Vec3[T]) Normalize() Vec3[T] {
 	length := v.Length()
-	if length >= Epsilon {
+	if length >= epsilonFor[T]() {
 		return v.Div(length)
 	}
 	return v
 }
 
 // Cross calculates the cross product of two vectors.
-func (v Vec3D) Cross(other Vec3D) Vec3D {
-	return Vec3D{
+func (v Vec3[T]) Cross(other Vec3[T]) Vec3[T] {
+	return Vec3[T]{
 		X: v.Y*other.Z - v.Z*other.Y,
 		Y: v.Z*other.X - v.X*other.Z,
 		Z: v.X*other.Y - v.Y*other.X,
@@ -49,8 +115,8 @@ func (v Vec3D) Cross(other Vec3D) Vec3D {
 }
 
 // MultiplyScalar scales the vector by a scalar value.
-func (v Vec3D) MultiplyScalar(scalar float64) Vec3D {
-	return Vec3D{
+func (v Vec3[T]) MultiplyScalar(scalar T) Vec3[T] {
+	return Vec3[T]{
 		X: v.X * scalar,
 		Y: v.Y * scalar,
 		Z: v.Z * scalar,
@@ -58,13 +124,13 @@ func (v Vec3D) MultiplyScalar(scalar float64) Vec3D {
 }
 
 // ScalarProduct calculates the dot product of two vectors.
-func (v Vec3D) ScalarProduct(other Vec3D) float64 {
+func (v Vec3[T]) ScalarProduct(other Vec3[T]) T {
 	return v.X*other.X + v.Y*other.Y + v.Z*other.Z
 }
 
 // Subtract subtracts another vector from this vector.
-func (v Vec3D) Subtract(other Vec3D) Vec3D {
-	return Vec3D{
+func (v Vec3[T]) Subtract(other Vec3[T]) Vec3[T] {
+	return Vec3[T]{
 		X: v.X - other.X,
 		Y: v.Y - other.Y,
 		Z: v.Z - other.Z,
@@ -72,13 +138,13 @@ func (v Vec3D) Subtract(other Vec3D) Vec3D {
 }
 
 // DivideScalar divides the vector by a scalar value.
-func (v Vec3D) DivideScalar(scalar float64) Vec3D {
+func (v Vec3[T]) DivideScalar(scalar T) Vec3[T] {
 	return v.MultiplyScalar(1.0 / scalar)
 }
 
 // Add adds another vector to this vector.
-func (v Vec3D) Add(other Vec3D) Vec3D {
-	return Vec3D{
+func (v Vec3[T]) Add(other Vec3[T]) Vec3[T] {
+	return Vec3[T]{
 		X: v.X + other.X,
 		Y: v.Y + other.Y,
 		Z: v.Z + other.Z,
@@ -86,48 +152,67 @@ func (v Vec3D) Add(other Vec3D) Vec3D {
 }
 
 // Div divides the vector by a scalar value and returns the resulting vector.
-func (v Vec3D) Div(scalar float64) Vec3D {
+func (v Vec3[T]) Div(scalar T) Vec3[T] {
 	return v.DivideScalar(scalar)
 }
 
 // Angle calculates the angle (in degrees) between this vector and another vector.
-func (v Vec3D) Angle(other Vec3D) float64 {
+func (v Vec3[T]) Angle(other Vec3[T]) T {
 	dotProduct := v.ScalarProduct(other)
 	lengths := v.Length() * other.Length()
-	if lengths < Epsilon {
+	if lengths < epsilonFor[T]() {
 		return 0
 	}
-	return RadToDeg(math.Acos(dotProduct / lengths))
+	return RadToDeg(acosT(dotProduct / lengths))
 }
 
 // RadToDeg converts radians to degrees.
-func RadToDeg(radians float64) float64 {
-	return radians * 180.0 / math.Pi
+func RadToDeg[T Float](radians T) T {
+	return radians * 180.0 / T(math.Pi)
 }
 
 // DegToRad converts degrees to radians.
-func DegToRad(degrees float64) float64 {
-	return degrees * math.Pi / 180.0
+func DegToRad[T Float](degrees T) T {
+	return degrees * T(math.Pi) / 180.0
 }
 
 // Print outputs the vector in a formatted string.
-func (v Vec3D) Print() {
+func (v Vec3[T]) Print() {
 	fmt.Printf("[%.1f/%.1f/%.1f]\n", v.X, v.Y, v.Z)
 }
 
 // Quaternion Functions
 
 // NewQuaternion creates a quaternion from an axis and an angle.
-func NewQuaternion(axis Vec3D, angle float64) Quaternion {
-	return Quaternion{
-		S: math.Cos(angle / 2.0),
-		V: axis.MultiplyScalar(math.Sin(angle / 2.0)),
+func NewQuaternion[T Float](axis Vec3[T], angle T) Quat[T] {
+	return Quat[T]{
+		S: cosT(angle / 2.0),
+		V: axis.MultiplyScalar(sinT(angle / 2.0)),
 	}
 }
 
+// AxisAngle extracts the rotation axis and angle (in radians) encoded by
+// a unit quaternion - the inverse of NewQuaternion. It guards the poles
+// (s close to +-1, i.e. no rotation) by returning (Vec3{1,0,0}, 0)
+// instead of dividing by a near-zero sine.
+func (q Quat[T]) AxisAngle() (Vec3[T], T) {
+	s := q.S
+	if s > 1.0 {
+		s = 1.0
+	} else if s < -1.0 {
+		s = -1.0
+	}
+
+	sinHalfAngle := sqrtT(1.0 - s*s)
+	if sinHalfAngle < epsilonFor[T]() {
+		return Vec3[T]{X: 1, Y: 0, Z: 0}, 0
+	}
+	return q.V.MultiplyScalar(1.0 / sinHalfAngle), 2.0 * acosT(s)
+}
+
 // Multiply performs quaternion multiplication (non-commutative).
-func (q Quaternion) Multiply(other Quaternion) Quaternion {
-	return Quaternion{
+func (q Quat[T]) Multiply(other Quat[T]) Quat[T] {
+	return Quat[T]{
 		S: q.S*other.S - q.V.ScalarProduct(other.V),
 		V: q.V.Cross(other.V).
 			Add(other.V.MultiplyScalar(q.S)).
@@ -136,80 +221,175 @@ func (q Quaternion) Multiply(other Quaternion) Quaternion {
 }
 
 // MultiplyScalar scales the quaternion by a scalar value.
-func (q Quaternion) MultiplyScalar(scalar float64) Quaternion {
-	return Quaternion{
+func (q Quat[T]) MultiplyScalar(scalar T) Quat[T] {
+	return Quat[T]{
 		S: q.S * scalar,
 		V: q.V.MultiplyScalar(scalar),
 	}
 }
 
 // Add adds two quaternions together.
-func (q Quaternion) Add(other Quaternion) Quaternion {
-	return Quaternion{
+func (q Quat[T]) Add(other Quat[T]) Quat[T] {
+	return Quat[T]{
 		S: q.S + other.S,
 		V: q.V.Add(other.V),
 	}
 }
 
 // Subtract subtracts another quaternion from this quaternion.
-func (q Quaternion) Subtract(other Quaternion) Quaternion {
-	return Quaternion{
+func (q Quat[T]) Subtract(other Quat[T]) Quat[T] {
+	return Quat[T]{
 		S: q.S - other.S,
 		V: q.V.Subtract(other.V),
 	}
 }
 
 // Conjugate returns the conjugate of the quaternion.
-func (q Quaternion) Conjugate() Quaternion {
-	return Quaternion{
+func (q Quat[T]) Conjugate() Quat[T] {
+	return Quat[T]{
 		S: q.S,
 		V: q.V.MultiplyScalar(-1.0),
 	}
 }
 
 // Inverse calculates the inverse of the quaternion.
-func (q Quaternion) Inverse() Quaternion {
+func (q Quat[T]) Inverse() Quat[T] {
 	lengthSquared := q.Length() * q.Length()
-	if lengthSquared < Epsilon {
+	if lengthSquared < epsilonFor[T]() {
 		return q // Avoid division by zero
 	}
 	return q.Conjugate().MultiplyScalar(1.0 / lengthSquared)
 }
 
 // Normalize scales the quaternion to have a length of 1, if possible.
-func (q Quaternion) Normalize() Quaternion {
+func (q Quat[T]) Normalize() Quat[T] {
 	length := q.Length()
-	if length < Epsilon {
+	if length < epsilonFor[T]() {
 		return q
 	}
-	return Quaternion{
+	return Quat[T]{
 		S: q.S / length,
 		V: q.V.MultiplyScalar(1.0 / length),
 	}
 }
 
 // Length calculates the magnitude of the quaternion.
-func (q Quaternion) Length() float64 {
-	return math.Sqrt(q.S*q.S + q.V.X*q.V.X + q.V.Y*q.V.Y + q.V.Z*q.V.Z)
+func (q Quat[T]) Length() T {
+	return sqrtT(q.S*q.S + q.V.X*q.V.X + q.V.Y*q.V.Y + q.V.Z*q.V.Z)
 }
 
 // IsNormalized checks if the quaternion is normalized.
-func (q Quaternion) IsNormalized() bool {
+func (q Quat[T]) IsNormalized() bool {
 	lengthSquared := q.S*q.S + q.V.X*q.V.X + q.V.Y*q.V.Y + q.V.Z*q.V.Z
-	return math.Abs(lengthSquared-1.0) <= Epsilon
+	e := epsilonFor[T]()
+	diff := lengthSquared - 1.0
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff <= e
+}
+
+// ScalarProduct calculates the dot product of two quaternions.
+func (q Quat[T]) ScalarProduct(other Quat[T]) T {
+	return q.S*other.S + q.V.ScalarProduct(other.V)
+}
+
+// Exp computes the exponential of a quaternion. For a pure quaternion (S == 0)
+// this returns a unit quaternion, which is the operation Squad needs to turn a
+// scaled rotation axis back into a rotation.
+func (q Quat[T]) Exp() Quat[T] {
+	scale := T(math.Exp(float64(q.S)))
+	theta := q.V.Length()
+	if theta < epsilonFor[T]() {
+		return Quat[T]{S: scale * cosT(theta), V: Vec3[T]{}}
+	}
+	return Quat[T]{
+		S: scale * cosT(theta),
+		V: q.V.Normalize().MultiplyScalar(scale * sinT(theta)),
+	}
+}
+
+// Log computes the logarithm of a unit quaternion, returning a pure quaternion
+// (0, axis*theta). This is the inverse of Exp and is used to find the tangent
+// quaternions consumed by Squad.
+func (q Quat[T]) Log() Quat[T] {
+	s := q.S
+	if s > 1.0 {
+		s = 1.0
+	} else if s < -1.0 {
+		s = -1.0
+	}
+	theta := acosT(s)
+	if theta < epsilonFor[T]() {
+		return Quat[T]{S: 0, V: Vec3[T]{}}
+	}
+	return Quat[T]{S: 0, V: q.V.Normalize().MultiplyScalar(theta)}
+}
+
+// Pow raises a unit quaternion to a real exponent t, scaling the rotation
+// angle around its axis by t.
+func (q Quat[T]) Pow(t T) Quat[T] {
+	return q.Log().MultiplyScalar(t).Exp()
+}
+
+// Slerp performs spherical linear interpolation between two quaternions,
+// taking the shortest arc on the 3-sphere. It falls back to Nlerp when the
+// quaternions are nearly parallel to avoid dividing by a near-zero sin(theta).
+func Slerp[T Float](a, b Quat[T], t T) Quat[T] {
+	dot := a.ScalarProduct(b)
+	if dot < 0 {
+		b = b.MultiplyScalar(-1.0)
+		dot = -dot
+	}
+	if dot > 1.0-epsilonFor[T]() {
+		return Nlerp(a, b, t)
+	}
+
+	theta := acosT(dot)
+	sinTheta := sinT(theta)
+	wa := sinT((1.0-t)*theta) / sinTheta
+	wb := sinT(t*theta) / sinTheta
+
+	return a.MultiplyScalar(wa).Add(b.MultiplyScalar(wb))
+}
+
+// Nlerp performs normalized linear interpolation between two quaternions.
+// It is cheaper than Slerp and a good approximation for small angles.
+func Nlerp[T Float](a, b Quat[T], t T) Quat[T] {
+	return a.MultiplyScalar(1.0 - t).Add(b.MultiplyScalar(t)).Normalize()
+}
+
+// squadIntermediate computes the control quaternion s_i used by Squad for the
+// quaternion q, given its neighbours qPrev and qNext:
+//
+//	s_i = q_i * exp(-(log(q_i^-1 * q_{i+1}) + log(q_i^-1 * q_{i-1})) / 4)
+func squadIntermediate[T Float](qPrev, q, qNext Quat[T]) Quat[T] {
+	qInv := q.Inverse()
+	sum := qInv.Multiply(qNext).Log().Add(qInv.Multiply(qPrev).Log())
+	return q.Multiply(sum.MultiplyScalar(-0.25).Exp())
+}
+
+// Squad performs cubic spherical interpolation between q1 and q2 at t,
+// using q0 and q3 as the outer control points to shape the tangents. It is
+// built from nested Slerps through the intermediate control quaternions
+// returned by squadIntermediate.
+func Squad[T Float](q0, q1, q2, q3 Quat[T], t T) Quat[T] {
+	s1 := squadIntermediate(q0, q1, q2)
+	s2 := squadIntermediate(q1, q2, q3)
+	return Slerp(Slerp(q1, q2, t), Slerp(s1, s2, t), 2.0*t*(1.0-t))
 }
 
 // RotatePointWithQuaternion rotates a point using the quaternion.
-func RotatePointWithQuaternion(q Quaternion, point Vec3D) Vec3D {
+func RotatePointWithQuaternion[T Float](q Quat[T], point Vec3[T]) Vec3[T] {
 	normQ := q.Normalize()
-	pointQ := Quaternion{S: 0.0, V: point}
+	pointQ := Quat[T]{S: 0.0, V: point}
 
 	rotatedQ := normQ.Multiply(pointQ).Multiply(normQ.Inverse())
 	return rotatedQ.V
 }
 
 // RotatePointAroundAxis rotates a point around an axis by a given angle.
-func RotatePointAroundAxis(axis Vec3D, angle float64, point Vec3D) Vec3D {
+func RotatePointAroundAxis[T Float](axis Vec3[T], angle T, point Vec3[T]) Vec3[T] {
 	q := NewQuaternion(axis, angle)
 	return RotatePointWithQuaternion(q, point)
 }
@@ -0,0 +1,111 @@
+package vector3d
+
+import (
+	"math"
+	"testing"
+)
+
+func approxEqualFloat(a, b, tol float64) bool {
+	return math.Abs(a-b) <= tol
+}
+
+func approxEqualQuat(a, b Quaternion, tol float64) bool {
+	return approxEqualFloat(a.S, b.S, tol) &&
+		approxEqualFloat(a.V.X, b.V.X, tol) &&
+		approxEqualFloat(a.V.Y, b.V.Y, tol) &&
+		approxEqualFloat(a.V.Z, b.V.Z, tol)
+}
+
+func TestSlerpEndpointsRecoverInputs(t *testing.T) {
+	a := NewQuaternion(Vec3D{X: 0, Y: 0, Z: 1}, 0.3)
+	b := NewQuaternion(Vec3D{X: 0, Y: 1, Z: 0}, 1.2)
+
+	if got := Slerp(a, b, 0); !approxEqualQuat(got, a, 1e-9) {
+		t.Errorf("Slerp(a, b, 0) = %+v, want %+v", got, a)
+	}
+	if got := Slerp(a, b, 1); !approxEqualQuat(got, b, 1e-9) {
+		t.Errorf("Slerp(a, b, 1) = %+v, want %+v", got, b)
+	}
+}
+
+func TestSlerpMidpointMatchesHalfAngle(t *testing.T) {
+	axis := Vec3D{X: 0, Y: 0, Z: 1}
+	a := NewQuaternion(axis, 0)
+	b := NewQuaternion(axis, math.Pi/2)
+
+	got := Slerp(a, b, 0.5)
+	want := NewQuaternion(axis, math.Pi/4)
+	if !approxEqualQuat(got, want, 1e-9) {
+		t.Errorf("Slerp(a, b, 0.5) = %+v, want %+v", got, want)
+	}
+}
+
+func TestSlerpMidpointIsEquidistantAndUnit(t *testing.T) {
+	a := NewQuaternion(Vec3D{X: 1, Y: 0, Z: 0}, 0.1)
+	b := NewQuaternion(Vec3D{X: 0, Y: 1, Z: 0}, 1.4)
+
+	mid := Slerp(a, b, 0.5)
+	if !approxEqualFloat(mid.Length(), 1, 1e-9) {
+		t.Errorf("Slerp midpoint length = %v, want 1", mid.Length())
+	}
+
+	distToA := mid.ScalarProduct(a)
+	distToB := mid.ScalarProduct(b)
+	if !approxEqualFloat(distToA, distToB, 1e-9) {
+		t.Errorf("Slerp midpoint is not equidistant on the 3-sphere: dot(mid,a)=%v dot(mid,b)=%v", distToA, distToB)
+	}
+}
+
+func TestNlerpEndpoints(t *testing.T) {
+	a := NewQuaternion(Vec3D{X: 0, Y: 1, Z: 0}, 0.2)
+	b := NewQuaternion(Vec3D{X: 1, Y: 0, Z: 0}, 0.9)
+
+	if got := Nlerp(a, b, 0); !approxEqualQuat(got, a, 1e-9) {
+		t.Errorf("Nlerp(a, b, 0) = %+v, want %+v", got, a)
+	}
+	if got := Nlerp(a, b, 1); !approxEqualQuat(got, b, 1e-9) {
+		t.Errorf("Nlerp(a, b, 1) = %+v, want %+v", got, b)
+	}
+}
+
+func TestSquadEndpointsRecoverInnerControlPoints(t *testing.T) {
+	q0 := NewQuaternion(Vec3D{X: 1, Y: 0, Z: 0}, -0.4)
+	q1 := NewQuaternion(Vec3D{X: 0, Y: 0, Z: 1}, 0.1)
+	q2 := NewQuaternion(Vec3D{X: 0, Y: 0, Z: 1}, 0.8)
+	q3 := NewQuaternion(Vec3D{X: 0, Y: 1, Z: 0}, 1.5)
+
+	if got := Squad(q0, q1, q2, q3, 0); !approxEqualQuat(got, q1, 1e-9) {
+		t.Errorf("Squad(..., 0) = %+v, want %+v", got, q1)
+	}
+	if got := Squad(q0, q1, q2, q3, 1); !approxEqualQuat(got, q2, 1e-9) {
+		t.Errorf("Squad(..., 1) = %+v, want %+v", got, q2)
+	}
+}
+
+func TestExpLogRoundTrip(t *testing.T) {
+	q := NewQuaternion(Vec3D{X: 0, Y: 1, Z: 0}, 1.1)
+	if got := q.Log().Exp(); !approxEqualQuat(got, q, 1e-9) {
+		t.Errorf("q.Log().Exp() = %+v, want %+v", got, q)
+	}
+}
+
+func TestPowOne(t *testing.T) {
+	q := NewQuaternion(Vec3D{X: 1, Y: 1, Z: 0}.Normalize(), 0.7)
+	if got := q.Pow(1); !approxEqualQuat(got, q, 1e-9) {
+		t.Errorf("q.Pow(1) = %+v, want %+v", got, q)
+	}
+}
+
+func TestAxisAngleRoundTrip(t *testing.T) {
+	wantAxis := Vec3D{X: 1, Y: 2, Z: 3}.Normalize()
+	wantAngle := 1.3
+	q := NewQuaternion(wantAxis, wantAngle)
+
+	axis, angle := q.AxisAngle()
+	if !approxEqualFloat(angle, wantAngle, 1e-9) {
+		t.Errorf("angle = %v, want %v", angle, wantAngle)
+	}
+	if math.Abs(axis.X-wantAxis.X) > 1e-9 || math.Abs(axis.Y-wantAxis.Y) > 1e-9 || math.Abs(axis.Z-wantAxis.Z) > 1e-9 {
+		t.Errorf("axis = %+v, want %+v", axis, wantAxis)
+	}
+}